@@ -0,0 +1,19 @@
+// Package backoff fornece o cálculo de atraso entre tentativas compartilhado
+// pelo cliente e pelo servidor.
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+// WithJitter calcula um atraso exponencial limitado a maxDelay, com jitter
+// de até 50% para evitar rajadas sincronizadas de retry entre instâncias.
+func WithJitter(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base * time.Duration(1<<uint(attempt-1))
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
@@ -1,26 +1,87 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/wellalencarweb/client-server-api/backoff"
+	"github.com/wellalencarweb/client-server-api/client/clientlog"
+	"github.com/wellalencarweb/client-server-api/metrics"
+	"github.com/wellalencarweb/client-server-api/telemetry"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
 )
 
 const (
 	serverURL          = "http://localhost:8080/cotacao"
+	streamURL          = "http://localhost:8080/cotacao/stream"
 	timeoutDuration    = 300 * time.Millisecond
 	logFile            = "cotacao_log.txt"
 	outputFile         = "cotacao.txt"
+	metricsAddress     = ":9091"
 	ErrInvalidResponse = "Resposta inválida do servidor"
 )
 
+// httpClient é o client HTTP do cliente, instrumentado para propagar o
+// contexto de tracing (traceparent) em toda requisição ao servidor.
+var httpClient = &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
+var tracer = otel.Tracer("github.com/wellalencarweb/client-server-api/client")
+
+// logger é o subsistema de log estruturado do cliente, inicializado em main.
+var logger *clientlog.Logger
+
+// tick é o evento recebido via SSE em --follow, no mesmo formato publicado
+// pelo hub do servidor.
+type tick struct {
+	Bid string `json:"bid"`
+	Ts  int64  `json:"ts"`
+}
+
 func main() {
+	follow := flag.Bool("follow", false, "acompanha /cotacao/stream via SSE, anexando cada cotação em "+outputFile)
+	flag.Parse()
+
+	logger = newClientLogger()
+	defer logger.Close()
+
+	ctx := context.Background()
+	shutdownTracing, err := telemetry.Init(ctx, telemetry.LoadConfig("client-server-api-client"))
+	if err != nil {
+		log.Fatalf("Erro ao configurar tracing: %v", err)
+		return
+	}
+	defer shutdownTracing(ctx)
+
+	if *follow {
+		// --follow é um processo de longa duração: vale a pena expor /metrics.
+		go func() {
+			http.Handle("/metrics", promhttp.Handler())
+			if err := http.ListenAndServe(metricsAddress, nil); err != nil {
+				log.Printf("Erro ao servir /metrics: %v", err)
+			}
+		}()
+
+		if err := followStream(streamURL); err != nil {
+			logError(fmt.Errorf("erro ao acompanhar stream de cotações: %w", err))
+			fmt.Println("Erro ao acompanhar cotações.")
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Criar um contexto com timeout
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutDuration)
 	defer cancel()
@@ -43,30 +104,187 @@ func main() {
 	fmt.Println("Cotação salva com sucesso em", outputFile)
 }
 
-// fetchDollarQuote faz uma requisição HTTP ao servidor para obter a cotação do dólar
+// newClientLogger monta o Logger a partir de variáveis de ambiente,
+// degradando para um logger que descarta mensagens caso o arquivo de log
+// não possa ser aberto, para não impedir a execução do cliente.
+func newClientLogger() *clientlog.Logger {
+	l, err := clientlog.New(clientlog.Config{
+		Path:         getEnv("LOG_FILE", logFile),
+		MaxSizeBytes: envInt64("LOG_MAX_SIZE_BYTES", 1<<20), // 1 MiB
+		MaxBackups:   int(envInt64("LOG_MAX_BACKUPS", 5)),
+		MaxAge:       envDuration("LOG_MAX_AGE", 7*24*time.Hour),
+	})
+	if err != nil {
+		log.Printf("Erro ao inicializar log estruturado, descartando logs: %v", err)
+		return clientlog.NewDiscard()
+	}
+	return l
+}
+
+// getEnv retorna o valor de uma variável de ambiente ou um fallback
+func getEnv(key, fallback string) string {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	return value
+}
+
+func envInt64(key string, fallback int64) int64 {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+// followStream conecta ao endpoint SSE do servidor e anexa cada cotação
+// recebida em outputFile, até a conexão ser encerrada ou falhar.
+func followStream(url string) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("erro ao criar requisição de stream: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao conectar ao stream: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s. Status: %d", ErrInvalidResponse, resp.StatusCode)
+	}
+
+	f, err := os.OpenFile(outputFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("erro ao abrir %s: %w", outputFile, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok {
+			continue
+		}
+
+		var t tick
+		if err := json.Unmarshal([]byte(data), &t); err != nil {
+			logError(fmt.Errorf("erro ao decodificar evento do stream: %w", err))
+			continue
+		}
+
+		entry := fmt.Sprintf("Dólar: %s\n", t.Bid)
+		if _, err := f.WriteString(entry); err != nil {
+			return fmt.Errorf("erro ao anexar cotação em %s: %w", outputFile, err)
+		}
+		fmt.Print(entry)
+	}
+	return scanner.Err()
+}
+
+// fetchDollarQuote busca a cotação do dólar no servidor, tentando novamente
+// conforme a RetryPolicy configurada até esgotar as tentativas ou o
+// contexto expirar.
 func fetchDollarQuote(ctx context.Context, url string) (string, error) {
+	return fetchDollarQuoteWithRetry(ctx, url, retryPolicyFromEnv())
+}
+
+// retryPolicyFromEnv monta a RetryPolicy a partir de variáveis de ambiente,
+// caindo para DefaultRetryPolicy quando não configuradas.
+func retryPolicyFromEnv() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: int(envInt64("FETCH_MAX_ATTEMPTS", int64(DefaultRetryPolicy.MaxAttempts))),
+		BaseDelay:   envDuration("FETCH_RETRY_BASE_DELAY", DefaultRetryPolicy.BaseDelay),
+		MaxDelay:    envDuration("FETCH_RETRY_MAX_DELAY", DefaultRetryPolicy.MaxDelay),
+	}
+}
+
+// fetchDollarQuoteWithRetry executa fetchDollarQuoteOnce repetidamente,
+// registrando cada tentativa (número, latência e classe do erro) no log
+// estruturado e respeitando o deadline do ctx entre tentativas.
+func fetchDollarQuoteWithRetry(ctx context.Context, url string, policy RetryPolicy) (string, error) {
+	ctx, span := tracer.Start(ctx, "fetchDollarQuote")
+	defer span.End()
+
+	var lastErr error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		start := time.Now()
+		bid, err := fetchDollarQuoteOnce(ctx, url)
+		latency := time.Since(start)
+		metrics.ObserveFetch("server", latency)
+
+		if err == nil {
+			logger.Info("fetchDollarQuote: tentativa bem-sucedida",
+				"attempt", attempt, "latency_ms", latency.Milliseconds())
+			return bid, nil
+		}
+
+		class := classifyError(err)
+		metrics.IncUpstreamError("server")
+		span.RecordError(err)
+		logger.Warn("fetchDollarQuote: tentativa falhou",
+			"attempt", attempt, "latency_ms", latency.Milliseconds(),
+			"error_class", string(class), "error", err.Error())
+
+		lastErr = err
+
+		if attempt == policy.MaxAttempts {
+			break
+		}
+
+		delay := backoff.WithJitter(policy.BaseDelay, policy.MaxDelay, attempt)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	return "", lastErr
+}
+
+// fetchDollarQuoteOnce faz uma única requisição HTTP ao servidor para obter
+// a cotação do dólar, sem nenhuma lógica de retry.
+func fetchDollarQuoteOnce(ctx context.Context, url string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return "", fmt.Errorf("erro ao criar requisição: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return "", fmt.Errorf("timeout na requisição ao servidor: %w", err)
-		}
 		return "", fmt.Errorf("erro ao realizar requisição ao servidor: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("%s. Status: %d, Mensagem: %s", ErrInvalidResponse, resp.StatusCode, string(body))
+		return "", &httpStatusError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	var data map[string]string
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return "", fmt.Errorf("erro ao decodificar resposta do servidor: %w", err)
+		return "", &decodeError{err: err}
 	}
 
 	bid, ok := data["bid"]
@@ -77,7 +295,7 @@ func fetchDollarQuote(ctx context.Context, url string) (string, error) {
 	return bid, nil
 }
 
-// saveQuoteToFile salva a cotação em um arquivo
+// saveQuoteToFile sobrescreve o arquivo de saída com a cotação atual.
 func saveQuoteToFile(filename, content string) error {
 	if filename == "" {
 		return errors.New("nome do arquivo não pode ser nulo")
@@ -88,11 +306,8 @@ func saveQuoteToFile(filename, content string) error {
 	return os.WriteFile(filename, []byte(content), 0644)
 }
 
-// logError salva mensagens de erro em um arquivo de log
+// logError registra uma mensagem de erro no log estruturado do cliente.
 func logError(err error) {
-	logMessage := fmt.Sprintf("%s: %v\n", time.Now().Format(time.RFC3339), err)
-	if logErr := os.WriteFile(logFile, []byte(logMessage), os.ModeAppend|0644); logErr != nil {
-		log.Printf("Erro ao salvar no log: %v", logErr)
-	}
+	logger.Error(err.Error())
 	log.Println(err)
 }
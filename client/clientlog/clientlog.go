@@ -0,0 +1,61 @@
+// Package clientlog fornece o subsistema de log estruturado do cliente:
+// saída JSON via log/slog, com rotação do arquivo por tamanho.
+package clientlog
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Config controla o arquivo de destino e a política de rotação.
+type Config struct {
+	Path string
+
+	// MaxSizeBytes é o tamanho máximo do arquivo ativo antes de rotacionar.
+	MaxSizeBytes int64
+	// MaxBackups é o número máximo de arquivos rotacionados mantidos.
+	MaxBackups int
+	// MaxAge é a idade máxima de um arquivo rotacionado antes de ser removido.
+	MaxAge time.Duration
+}
+
+// Logger é um *slog.Logger com rotação de arquivo, que deve ser fechado
+// (via Close) quando o programa encerrar.
+type Logger struct {
+	*slog.Logger
+	rotator *rotator
+}
+
+// New cria o Logger configurado, abrindo (ou criando) o arquivo de destino.
+func New(cfg Config) (*Logger, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("clientlog: Config.Path não pode ser vazio")
+	}
+
+	r, err := newRotator(cfg.Path, cfg.MaxSizeBytes, cfg.MaxBackups, cfg.MaxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := slog.NewJSONHandler(r, &slog.HandlerOptions{Level: slog.LevelInfo})
+	return &Logger{Logger: slog.New(handler), rotator: r}, nil
+}
+
+// Close fecha o arquivo de log subjacente.
+func (l *Logger) Close() error {
+	if l.rotator == nil {
+		return nil
+	}
+	return l.rotator.Close()
+}
+
+// NewDiscard cria um Logger que descarta todas as mensagens, útil quando a
+// inicialização do arquivo de log falha e não deve ser fatal para o cliente.
+func NewDiscard() *Logger {
+	return &Logger{Logger: slog.New(slog.NewJSONHandler(discardWriter{}, nil))}
+}
+
+type discardWriter struct{}
+
+func (discardWriter) Write(p []byte) (int, error) { return len(p), nil }
@@ -0,0 +1,129 @@
+package clientlog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotator é um io.Writer que rotaciona o arquivo de destino por tamanho,
+// mantendo no máximo maxBackups arquivos antigos com até maxAge de idade.
+type rotator struct {
+	mu sync.Mutex
+
+	path       string
+	maxSize    int64
+	maxBackups int
+	maxAge     time.Duration
+
+	file *os.File
+	size int64
+}
+
+func newRotator(path string, maxSize int64, maxBackups int, maxAge time.Duration) (*rotator, error) {
+	r := &rotator{path: path, maxSize: maxSize, maxBackups: maxBackups, maxAge: maxAge}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotator) open() error {
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("erro ao abrir arquivo de log %s: %w", r.path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("erro ao inspecionar arquivo de log %s: %w", r.path, err)
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+// Write implementa io.Writer, rotacionando o arquivo antes de escrever caso
+// a escrita ultrapasse maxSize.
+func (r *rotator) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxSize > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotator) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("erro ao fechar arquivo de log %s: %w", r.path, err)
+	}
+
+	backupPath := fmt.Sprintf("%s.%s", r.path, time.Now().Format("20060102T150405.000000000"))
+	if err := os.Rename(r.path, backupPath); err != nil {
+		return fmt.Errorf("erro ao rotacionar arquivo de log %s: %w", r.path, err)
+	}
+
+	if err := r.open(); err != nil {
+		return err
+	}
+
+	r.prune()
+	return nil
+}
+
+// prune remove backups além de maxBackups ou mais antigos que maxAge.
+func (r *rotator) prune() {
+	dir := filepath.Dir(r.path)
+	base := filepath.Base(r.path)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), base+".") {
+			backups = append(backups, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(backups)
+
+	now := time.Now()
+	kept := 0
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		tooOld := r.maxAge > 0 && fileAge(b, now) > r.maxAge
+		tooMany := r.maxBackups > 0 && kept >= r.maxBackups
+		if tooOld || tooMany {
+			os.Remove(b)
+			continue
+		}
+		kept++
+	}
+}
+
+func fileAge(path string, now time.Time) time.Duration {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return now.Sub(info.ModTime())
+}
+
+func (r *rotator) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
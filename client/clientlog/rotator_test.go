@@ -0,0 +1,87 @@
+package clientlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRotator_RotatesWhenSizeExceeded(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cotacao_log.txt")
+
+	r, err := newRotator(path, 10, 0, 0)
+	if err != nil {
+		t.Fatalf("newRotator: %v", err)
+	}
+	defer r.Close()
+
+	if _, err := r.Write([]byte("0123456789")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := r.Write([]byte("abc")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("esperava arquivo atual + 1 backup após estourar maxSize, obteve %d entradas", len(entries))
+	}
+}
+
+func TestRotator_PruneRespectsMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cotacao_log.txt")
+
+	r, err := newRotator(path, 1, 2, 0)
+	if err != nil {
+		t.Fatalf("newRotator: %v", err)
+	}
+	defer r.Close()
+
+	for i := 0; i < 5; i++ {
+		if _, err := r.Write([]byte("xx")); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("esperava arquivo atual + no máximo 2 backups, obteve %d entradas", len(entries))
+	}
+}
+
+func TestRotator_PruneRemovesBackupsOlderThanMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cotacao_log.txt")
+
+	oldBackup := path + ".20000101T000000.000000000"
+	if err := os.WriteFile(oldBackup, []byte("old"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	oldTime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(oldBackup, oldTime, oldTime); err != nil {
+		t.Fatalf("Chtimes: %v", err)
+	}
+
+	r, err := newRotator(path, 1, 0, time.Hour)
+	if err != nil {
+		t.Fatalf("newRotator: %v", err)
+	}
+	defer r.Close()
+
+	if _, err := r.Write([]byte("xx")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	if _, err := os.Stat(oldBackup); !os.IsNotExist(err) {
+		t.Fatal("backup mais antigo que maxAge deveria ter sido removido")
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// RetryPolicy configura as tentativas de fetchDollarQuote contra o servidor.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy é usada quando nenhuma política é informada.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   50 * time.Millisecond,
+	MaxDelay:    500 * time.Millisecond,
+}
+
+// errorClass identifica a natureza de uma falha de tentativa, usada como
+// campo estruturado nos logs de retry.
+type errorClass string
+
+const (
+	errClassTimeout    errorClass = "timeout"
+	errClassTransport  errorClass = "transport"
+	errClassDecode     errorClass = "decode"
+	errClassHTTPStatus errorClass = "http_status"
+)
+
+// httpStatusError representa uma resposta HTTP com status inesperado.
+type httpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("%s. Status: %d, Mensagem: %s", ErrInvalidResponse, e.StatusCode, e.Body)
+}
+
+// decodeError representa uma falha ao decodificar o corpo da resposta.
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("erro ao decodificar resposta do servidor: %v", e.err)
+}
+func (e *decodeError) Unwrap() error { return e.err }
+
+// classifyError categoriza o erro de uma tentativa para fins de log estruturado.
+func classifyError(err error) errorClass {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return errClassHTTPStatus
+	}
+
+	var decErr *decodeError
+	if errors.As(err, &decErr) {
+		return errClassDecode
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errClassTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return errClassTimeout
+	}
+
+	return errClassTransport
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+type fakeTimeoutErr struct{}
+
+func (fakeTimeoutErr) Error() string   { return "deadline exceeded" }
+func (fakeTimeoutErr) Timeout() bool   { return true }
+func (fakeTimeoutErr) Temporary() bool { return true }
+
+var _ net.Error = fakeTimeoutErr{}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want errorClass
+	}{
+		{"http status", &httpStatusError{StatusCode: 500, Body: "oops"}, errClassHTTPStatus},
+		{"decode", &decodeError{err: errors.New("json inválido")}, errClassDecode},
+		{"context deadline", context.DeadlineExceeded, errClassTimeout},
+		{"net timeout", fakeTimeoutErr{}, errClassTimeout},
+		{"transport", errors.New("connection refused"), errClassTransport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.want {
+				t.Fatalf("classifyError(%v) = %v, esperado %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Package metrics centraliza as métricas Prometheus expostas pelo cliente e
+// pelo servidor em /metrics.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// FetchDuration mede o tempo de busca de uma cotação, seja pelo servidor
+	// junto a um provedor upstream ou pelo cliente junto ao servidor.
+	FetchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cotacao_fetch_duration_seconds",
+		Help:    "Duração da busca de uma cotação, em segundos, por provedor.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"provider"})
+
+	// DBInsertDuration mede o tempo gasto inserindo uma cotação no banco.
+	DBInsertDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cotacao_db_insert_duration_seconds",
+		Help:    "Duração da inserção de uma cotação no banco de dados, em segundos.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// UpstreamErrors conta falhas ao consultar um provedor upstream, por provedor.
+	UpstreamErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cotacao_upstream_errors_total",
+		Help: "Total de erros ao consultar um provedor de cotação upstream.",
+	}, []string{"provider"})
+
+	// CacheHits conta acertos no cache da última cotação.
+	CacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cotacao_cache_hits_total",
+		Help: "Total de acertos no cache de última cotação.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(FetchDuration, DBInsertDuration, UpstreamErrors, CacheHits)
+}
+
+// ObserveFetch registra a duração de uma busca de cotação para o provedor informado.
+func ObserveFetch(provider string, d time.Duration) {
+	FetchDuration.WithLabelValues(provider).Observe(d.Seconds())
+}
+
+// ObserveDBInsert registra a duração de uma inserção no banco de dados.
+func ObserveDBInsert(d time.Duration) {
+	DBInsertDuration.Observe(d.Seconds())
+}
+
+// IncUpstreamError incrementa o contador de erros do provedor informado.
+func IncUpstreamError(provider string) {
+	UpstreamErrors.WithLabelValues(provider).Inc()
+}
+
+// IncCacheHit incrementa o contador de acertos de cache.
+func IncCacheHit() {
+	CacheHits.Inc()
+}
@@ -0,0 +1,5 @@
+// Package proto contém o contrato gRPC do serviço de cotações e as ligações
+// Go geradas a partir de quote.proto via `go generate ./...`.
+package proto
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative quote.proto
@@ -0,0 +1,72 @@
+// Package cache implementa um cache LRU minimalista usado para servir a
+// última cotação sem ir ao banco a cada requisição.
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// entry guarda o par chave/valor dentro da lista de uso.
+type entry struct {
+	key   string
+	value interface{}
+}
+
+// LRU é um cache seguro para uso concorrente com capacidade fixa, que
+// descarta a entrada menos recentemente usada quando cheio.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// New cria um LRU com a capacidade informada. capacity <= 0 é tratado como 1.
+func New(capacity int) *LRU {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRU{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get retorna o valor associado à chave, marcando-o como recentemente usado.
+func (c *LRU) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*entry).value, true
+}
+
+// Set insere ou atualiza o valor associado à chave, evictando a entrada mais
+// antiga caso a capacidade seja excedida.
+func (c *LRU) Set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*entry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&entry{key: key, value: value})
+	c.items[key] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*entry).key)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package cache
+
+import "testing"
+
+func TestLRU_GetMissAndHit(t *testing.T) {
+	c := New(2)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("esperava miss em cache vazio")
+	}
+
+	c.Set("a", 1)
+	v, ok := c.Get("a")
+	if !ok || v.(int) != 1 {
+		t.Fatalf("Get(a) = %v, %v; esperado 1, true", v, ok)
+	}
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := New(2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // "a" agora é o mais recentemente usado; "b" vira candidato a eviction
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("esperava que 'b' tivesse sido evictado por ser o menos recentemente usado")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("'a' não deveria ter sido evictado")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatal("'c' deveria estar presente")
+	}
+}
+
+func TestLRU_SetUpdatesExistingKey(t *testing.T) {
+	c := New(2)
+
+	c.Set("a", 1)
+	c.Set("a", 2)
+
+	v, ok := c.Get("a")
+	if !ok || v.(int) != 2 {
+		t.Fatalf("Get(a) = %v, %v; esperado 2, true", v, ok)
+	}
+}
+
+func TestLRU_NonPositiveCapacityTreatedAsOne(t *testing.T) {
+	c := New(0)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("esperava que 'a' tivesse sido evictado com capacidade 1")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatal("'b' deveria estar presente")
+	}
+}
@@ -0,0 +1,89 @@
+// Package grpcserver expõe o QuoteService via gRPC, compartilhando a mesma
+// lógica de negócio usada pelos transportes REST e WebSocket.
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	pb "github.com/wellalencarweb/client-server-api/proto"
+	"github.com/wellalencarweb/client-server-api/server/quoteservice"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// server implementa pb.QuoteServiceServer delegando para quoteservice.Service.
+type server struct {
+	pb.UnimplementedQuoteServiceServer
+	svc *quoteservice.Service
+}
+
+// New monta um *grpc.Server com o QuoteService registrado.
+func New(svc *quoteservice.Service) *grpc.Server {
+	s := grpc.NewServer()
+	pb.RegisterQuoteServiceServer(s, &server{svc: svc})
+	return s
+}
+
+// Serve inicia o listener TCP em address e bloqueia servindo gRPC.
+func Serve(s *grpc.Server, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	return s.Serve(lis)
+}
+
+// GetLatest retorna a cotação mais recente conhecida pelo servidor.
+func (s *server) GetLatest(ctx context.Context, _ *pb.Empty) (*pb.Quote, error) {
+	quote, err := s.svc.GetLatest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(quote), nil
+}
+
+// Stream envia uma Quote a cada nova cotação salva pelo servidor.
+func (s *server) Stream(_ *pb.Empty, stream pb.QuoteService_StreamServer) error {
+	ticks, unsubscribe := s.svc.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case tick, ok := <-ticks:
+			if !ok {
+				return nil
+			}
+			quote := &pb.Quote{Bid: tick.Bid, CreatedAt: timestamppb.New(time.Unix(tick.Ts, 0))}
+			if err := stream.Send(quote); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// History envia as cotações salvas no intervalo informado, mais recentes primeiro.
+func (s *server) History(req *pb.Range, stream pb.QuoteService_HistoryServer) error {
+	quotes, err := s.svc.History(stream.Context(), req.GetFrom().AsTime(), req.GetTo().AsTime(), int(req.GetLimit()))
+	if err != nil {
+		return err
+	}
+
+	for _, q := range quotes {
+		if err := stream.Send(toProto(q)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toProto(q quoteservice.Quote) *pb.Quote {
+	return &pb.Quote{
+		Id:        uint32(q.ID),
+		Bid:       q.Bid,
+		CreatedAt: timestamppb.New(q.CreatedAt),
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wellalencarweb/client-server-api/server/quoteservice"
+)
+
+const defaultHistoryLimit = 50
+
+// handleHistory processa GET /cotacao/history?from=&to=&limit=, retornando
+// as cotações salvas no intervalo informado (RFC3339), mais recentes primeiro.
+func handleHistory(svc *quoteservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, err := parseTimeParam(r.URL.Query().Get("from"), time.Unix(0, 0))
+		if err != nil {
+			http.Error(w, "Parâmetro 'from' inválido", http.StatusBadRequest)
+			return
+		}
+		to, err := parseTimeParam(r.URL.Query().Get("to"), time.Now())
+		if err != nil {
+			http.Error(w, "Parâmetro 'to' inválido", http.StatusBadRequest)
+			return
+		}
+		limit := parseLimitParam(r.URL.Query().Get("limit"))
+
+		quotes, err := svc.History(r.Context(), from, to, limit)
+		if err != nil {
+			log.Printf("Erro ao consultar histórico de cotações: %v", err)
+			http.Error(w, "Erro ao consultar histórico de cotações", http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, quotes)
+	}
+}
+
+// handleLatest processa GET /cotacao/latest, servindo a partir do cache
+// sempre que possível para evitar ida desnecessária ao banco.
+func handleLatest(svc *quoteservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		quote, err := svc.GetLatest(r.Context())
+		if err != nil {
+			log.Printf("Erro ao consultar última cotação: %v", err)
+			http.Error(w, "Nenhuma cotação disponível", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, quote)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Erro ao codificar resposta JSON: %v", err)
+		http.Error(w, "Erro interno do servidor", http.StatusInternalServerError)
+	}
+}
+
+func parseTimeParam(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func parseLimitParam(value string) int {
+	if value == "" {
+		return defaultHistoryLimit
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return defaultHistoryLimit
+	}
+	if limit > quoteservice.MaxHistoryLimit {
+		return quoteservice.MaxHistoryLimit
+	}
+	return limit
+}
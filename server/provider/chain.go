@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/wellalencarweb/client-server-api/backoff"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/wellalencarweb/client-server-api/server/provider")
+
+// RetryPolicy configura as tentativas feitas contra um único provedor antes
+// de passar para o próximo da cadeia.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy é usada quando nenhuma política é informada.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 2,
+	BaseDelay:   50 * time.Millisecond,
+	MaxDelay:    500 * time.Millisecond,
+}
+
+// entry associa um provedor ao seu circuit breaker dentro da cadeia.
+type entry struct {
+	provider QuoteProvider
+	breaker  *CircuitBreaker
+	timeout  time.Duration
+}
+
+// Chain tenta buscar a cotação em uma sequência de provedores, pulando os
+// que estão com o circuito aberto e aplicando retry com backoff em cada um.
+type Chain struct {
+	entries  []entry
+	retry    RetryPolicy
+	Observer func(provider string, duration time.Duration, err error)
+}
+
+// ChainOption configura um provedor ao ser adicionado à Chain.
+type ChainOption struct {
+	Provider QuoteProvider
+	Timeout  time.Duration
+	// ErrorThreshold e MinSamples controlam quando o circuit breaker abre.
+	ErrorThreshold float64
+	MinSamples     int
+	ResetInterval  time.Duration
+}
+
+// NewChain monta a cadeia de provedores na ordem em que devem ser tentados.
+func NewChain(retry RetryPolicy, opts ...ChainOption) *Chain {
+	entries := make([]entry, 0, len(opts))
+	for _, opt := range opts {
+		entries = append(entries, entry{
+			provider: opt.Provider,
+			breaker:  NewCircuitBreaker(opt.ErrorThreshold, opt.MinSamples, opt.ResetInterval),
+			timeout:  opt.Timeout,
+		})
+	}
+	return &Chain{entries: entries, retry: retry}
+}
+
+// Fetch percorre os provedores em ordem, respeitando o circuit breaker de
+// cada um, e retorna a primeira cotação obtida com sucesso.
+func (c *Chain) Fetch(ctx context.Context) (Quote, error) {
+	ctx, span := tracer.Start(ctx, "provider.Chain.Fetch")
+	defer span.End()
+
+	var lastErr error
+
+	for _, e := range c.entries {
+		if !e.breaker.Allow() {
+			log.Printf("provider=%s circuito aberto, pulando", e.provider.Name())
+			continue
+		}
+
+		quote, err := c.fetchWithRetry(ctx, e)
+		if err == nil {
+			e.breaker.RecordSuccess()
+			return quote, nil
+		}
+
+		e.breaker.RecordFailure()
+		log.Printf("provider=%s falhou: %v", e.provider.Name(), err)
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		lastErr = ErrAllProvidersFailed
+	}
+	span.SetStatus(codes.Error, lastErr.Error())
+	return Quote{}, fmt.Errorf("%w: %v", ErrAllProvidersFailed, lastErr)
+}
+
+func (c *Chain) fetchWithRetry(ctx context.Context, e entry) (Quote, error) {
+	var lastErr error
+
+	for attempt := 1; attempt <= c.retry.MaxAttempts; attempt++ {
+		start := time.Now()
+		attemptCtx, span := tracer.Start(ctx, "provider.Fetch",
+			trace.WithAttributes(
+				attribute.String("provider", e.provider.Name()),
+				attribute.Int("attempt", attempt),
+			))
+		attemptCtx, cancel := context.WithTimeout(attemptCtx, e.timeout)
+		quote, err := e.provider.Fetch(attemptCtx)
+		cancel()
+		duration := time.Since(start)
+
+		if c.Observer != nil {
+			c.Observer(e.provider.Name(), duration, err)
+		}
+
+		if err == nil {
+			span.End()
+			return quote, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		lastErr = err
+
+		if attempt == c.retry.MaxAttempts {
+			break
+		}
+
+		delay := backoff.WithJitter(c.retry.BaseDelay, c.retry.MaxDelay, attempt)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return Quote{}, ctx.Err()
+		}
+	}
+
+	return Quote{}, lastErr
+}
+
+// Health descreve o estado de um provedor para o endpoint /health/providers.
+type Health struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// Health retorna o estado atual de cada provedor da cadeia, na ordem configurada.
+func (c *Chain) Health() []Health {
+	health := make([]Health, 0, len(c.entries))
+	for _, e := range c.entries {
+		health = append(health, Health{Name: e.provider.Name(), State: e.breaker.State().String()})
+	}
+	return health
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func retryFast() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+}
+
+func TestChain_FallsBackWhenPrimaryFails(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"USDBRL":{"bid":"5.25"}}`))
+	}))
+	defer healthy.Close()
+
+	chain := NewChain(retryFast(),
+		ChainOption{Provider: NewAwesomeAPIProvider(failing.URL, nil), Timeout: 50 * time.Millisecond, ErrorThreshold: 1, MinSamples: 1, ResetInterval: time.Second},
+		ChainOption{Provider: NewAwesomeAPIProvider(healthy.URL, nil), Timeout: 50 * time.Millisecond, ErrorThreshold: 1, MinSamples: 1, ResetInterval: time.Second},
+	)
+
+	quote, err := chain.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("esperava sucesso via fallback, obteve erro: %v", err)
+	}
+	if quote.Bid != "5.25" {
+		t.Fatalf("bid = %q, esperado 5.25", quote.Bid)
+	}
+}
+
+func TestChain_TimeoutTriggersNextProvider(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"USDBRL":{"bid":"9.99"}}`))
+	}))
+	defer slow.Close()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"USDBRL":{"bid":"5.25"}}`))
+	}))
+	defer healthy.Close()
+
+	chain := NewChain(RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		ChainOption{Provider: NewAwesomeAPIProvider(slow.URL, nil), Timeout: 20 * time.Millisecond, ErrorThreshold: 1, MinSamples: 1, ResetInterval: time.Second},
+		ChainOption{Provider: NewAwesomeAPIProvider(healthy.URL, nil), Timeout: 50 * time.Millisecond, ErrorThreshold: 1, MinSamples: 1, ResetInterval: time.Second},
+	)
+
+	quote, err := chain.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("esperava sucesso via fallback após timeout, obteve erro: %v", err)
+	}
+	if quote.Bid != "5.25" {
+		t.Fatalf("bid = %q, esperado 5.25", quote.Bid)
+	}
+}
+
+func TestChain_AllProvidersFail(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	chain := NewChain(retryFast(),
+		ChainOption{Provider: NewAwesomeAPIProvider(failing.URL, nil), Timeout: 50 * time.Millisecond, ErrorThreshold: 1, MinSamples: 1, ResetInterval: time.Second},
+	)
+
+	if _, err := chain.Fetch(context.Background()); err == nil {
+		t.Fatal("esperava erro quando todos os provedores falham")
+	}
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	cb := NewCircuitBreaker(0.5, 2, 50*time.Millisecond)
+
+	cb.RecordFailure()
+	if cb.State() != StateClosed {
+		t.Fatalf("circuito deveria seguir fechado com uma amostra, estado=%v", cb.State())
+	}
+
+	cb.RecordFailure()
+	if cb.State() != StateOpen {
+		t.Fatalf("circuito deveria abrir após atingir o threshold, estado=%v", cb.State())
+	}
+
+	if cb.Allow() {
+		t.Fatal("circuito aberto não deveria permitir novas chamadas antes do reset interval")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if !cb.Allow() {
+		t.Fatal("circuito deveria entrar em half-open após o reset interval")
+	}
+	if cb.State() != StateHalfOpen {
+		t.Fatalf("estado esperado half-open, obteve %v", cb.State())
+	}
+}
+
+func TestCircuitBreaker_WindowRollsOverSoOldSuccessesDontDiluteFailureRate(t *testing.T) {
+	cb := NewCircuitBreaker(0.5, 2, 30*time.Millisecond)
+
+	for i := 0; i < 100; i++ {
+		cb.RecordSuccess()
+	}
+	if cb.State() != StateClosed {
+		t.Fatalf("circuito deveria seguir fechado após sucessos, estado=%v", cb.State())
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	cb.RecordFailure()
+	cb.RecordFailure()
+	if cb.State() != StateOpen {
+		t.Fatalf("circuito deveria abrir com falhas na nova janela, sem diluição pelos 100 sucessos antigos, estado=%v", cb.State())
+	}
+}
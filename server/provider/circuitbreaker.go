@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"sync"
+	"time"
+)
+
+// State representa o estado do circuit breaker.
+type State int
+
+const (
+	// StateClosed permite requisições normalmente.
+	StateClosed State = iota
+	// StateOpen rejeita requisições imediatamente até o reset interval expirar.
+	StateOpen
+	// StateHalfOpen permite uma requisição de teste para decidir entre fechar ou reabrir.
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker implementa o padrão closed→open→half-open baseado em taxa
+// de erro numa janela deslizante simples de contadores, renovada a cada
+// resetInterval para que falhas antigas não permaneçam diluindo a taxa
+// indefinidamente.
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	errorThreshold float64 // taxa de erro (0-1) que abre o circuito
+	minSamples     int     // amostras mínimas antes de avaliar a taxa
+	resetInterval  time.Duration
+
+	state       State
+	openedAt    time.Time
+	windowStart time.Time
+	total       int
+	failures    int
+}
+
+// NewCircuitBreaker cria um breaker fechado com os parâmetros informados.
+func NewCircuitBreaker(errorThreshold float64, minSamples int, resetInterval time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		errorThreshold: errorThreshold,
+		minSamples:     minSamples,
+		resetInterval:  resetInterval,
+		state:          StateClosed,
+		windowStart:    time.Now(),
+	}
+}
+
+// Allow informa se uma nova requisição pode ser tentada neste momento.
+func (cb *CircuitBreaker) Allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case StateOpen:
+		if time.Since(cb.openedAt) >= cb.resetInterval {
+			cb.state = StateHalfOpen
+			return true
+		}
+		return false
+	case StateHalfOpen:
+		// A requisição de teste já foi liberada; aguarda seu resultado.
+		return false
+	default:
+		return true
+	}
+}
+
+// RecordSuccess registra uma chamada bem-sucedida.
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == StateHalfOpen {
+		cb.reset()
+		return
+	}
+
+	cb.rollWindow()
+	cb.total++
+}
+
+// RecordFailure registra uma chamada com falha e eventualmente abre o circuito.
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == StateHalfOpen {
+		cb.open()
+		return
+	}
+
+	cb.rollWindow()
+	cb.total++
+	cb.failures++
+
+	if cb.total >= cb.minSamples && cb.failureRate() >= cb.errorThreshold {
+		cb.open()
+	}
+}
+
+// rollWindow zera os contadores quando a janela atual expira, para que uma
+// rajada de falhas recentes não seja diluída por amostras antigas.
+func (cb *CircuitBreaker) rollWindow() {
+	if cb.resetInterval <= 0 || time.Since(cb.windowStart) < cb.resetInterval {
+		return
+	}
+	cb.total = 0
+	cb.failures = 0
+	cb.windowStart = time.Now()
+}
+
+// State retorna o estado atual do breaker.
+func (cb *CircuitBreaker) State() State {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+func (cb *CircuitBreaker) failureRate() float64 {
+	if cb.total == 0 {
+		return 0
+	}
+	return float64(cb.failures) / float64(cb.total)
+}
+
+func (cb *CircuitBreaker) open() {
+	cb.state = StateOpen
+	cb.openedAt = time.Now()
+}
+
+func (cb *CircuitBreaker) reset() {
+	cb.state = StateClosed
+	cb.total = 0
+	cb.failures = 0
+	cb.windowStart = time.Now()
+}
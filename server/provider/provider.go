@@ -0,0 +1,30 @@
+// Package provider implementa a camada de busca de cotações junto a APIs
+// externas, com suporte a múltiplos provedores, retries e circuit breaker.
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNoBid é retornado quando um provedor responde sem um campo de bid válido.
+var ErrNoBid = errors.New("campo 'bid' ausente ou inválido na resposta do provedor")
+
+// ErrAllProvidersFailed é retornado pela Chain quando nenhum provedor
+// conseguiu responder com sucesso.
+var ErrAllProvidersFailed = errors.New("todos os provedores de cotação falharam")
+
+// Quote é o resultado normalizado de uma consulta a um provedor.
+type Quote struct {
+	Bid string
+	At  time.Time
+}
+
+// QuoteProvider busca a cotação USD-BRL em uma API externa específica.
+type QuoteProvider interface {
+	// Name identifica o provedor em logs e no endpoint de health.
+	Name() string
+	// Fetch busca a cotação, respeitando o ctx para timeout/cancelamento.
+	Fetch(ctx context.Context) (Quote, error)
+}
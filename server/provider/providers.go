@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// tracedClient retorna um novo *http.Client cujo Transport propaga o
+// contexto de tracing (cabeçalho W3C traceparent) para a chamada upstream.
+// O client informado nunca é modificado; se nil, usa http.DefaultTransport
+// com timeout de 30s.
+func tracedClient(client *http.Client) *http.Client {
+	base := http.DefaultTransport
+	timeout := 30 * time.Second
+	if client != nil {
+		if client.Transport != nil {
+			base = client.Transport
+		}
+		timeout = client.Timeout
+	}
+	return &http.Client{Transport: otelhttp.NewTransport(base), Timeout: timeout}
+}
+
+// AwesomeAPIProvider busca a cotação na AwesomeAPI (economia.awesomeapi.com.br).
+type AwesomeAPIProvider struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// NewAwesomeAPIProvider cria o provedor com uma cópia instrumentada do client
+// informado (ver tracedClient), ou com um client padrão caso nil.
+func NewAwesomeAPIProvider(url string, client *http.Client) *AwesomeAPIProvider {
+	return &AwesomeAPIProvider{URL: url, HTTPClient: tracedClient(client)}
+}
+
+// Name identifica o provedor.
+func (p *AwesomeAPIProvider) Name() string { return "awesomeapi" }
+
+// Fetch consulta a AwesomeAPI e normaliza a resposta `{"USDBRL": {"bid": ...}}`.
+func (p *AwesomeAPIProvider) Fetch(ctx context.Context) (Quote, error) {
+	var data map[string]map[string]string
+	if err := fetchJSON(ctx, p.HTTPClient, p.URL, &data); err != nil {
+		return Quote{}, err
+	}
+
+	bid, ok := data["USDBRL"]["bid"]
+	if !ok || bid == "" {
+		return Quote{}, ErrNoBid
+	}
+	return Quote{Bid: bid, At: time.Now()}, nil
+}
+
+// ExchangeHostProvider busca a cotação em exchangerate.host, usado como
+// fallback quando a AwesomeAPI está indisponível.
+type ExchangeHostProvider struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// NewExchangeHostProvider cria o provedor com uma cópia instrumentada do
+// client informado (ver tracedClient), ou com um client padrão caso nil.
+func NewExchangeHostProvider(url string, client *http.Client) *ExchangeHostProvider {
+	return &ExchangeHostProvider{URL: url, HTTPClient: tracedClient(client)}
+}
+
+// Name identifica o provedor.
+func (p *ExchangeHostProvider) Name() string { return "exchangerate.host" }
+
+// Fetch consulta exchangerate.host e normaliza a resposta
+// `{"rates": {"BRL": 5.42}}` para o mesmo formato de bid em string.
+func (p *ExchangeHostProvider) Fetch(ctx context.Context) (Quote, error) {
+	var data struct {
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := fetchJSON(ctx, p.HTTPClient, p.URL, &data); err != nil {
+		return Quote{}, err
+	}
+
+	rate, ok := data.Rates["BRL"]
+	if !ok || rate == 0 {
+		return Quote{}, ErrNoBid
+	}
+	return Quote{Bid: fmt.Sprintf("%.4f", rate), At: time.Now()}, nil
+}
+
+// OfflineStubProvider é um provedor local de última instância, usado em
+// desenvolvimento e como fallback final quando todas as APIs externas falham.
+type OfflineStubProvider struct {
+	Bid string
+}
+
+// NewOfflineStubProvider cria o stub com o bid fixo informado.
+func NewOfflineStubProvider(bid string) *OfflineStubProvider {
+	return &OfflineStubProvider{Bid: bid}
+}
+
+// Name identifica o provedor.
+func (p *OfflineStubProvider) Name() string { return "offline-stub" }
+
+// Fetch sempre retorna o bid configurado, sem nenhuma chamada de rede.
+func (p *OfflineStubProvider) Fetch(ctx context.Context) (Quote, error) {
+	if p.Bid == "" {
+		return Quote{}, errors.New("offline-stub: nenhum bid configurado")
+	}
+	return Quote{Bid: p.Bid, At: time.Now()}, nil
+}
+
+// fetchJSON executa um GET e decodifica o corpo da resposta em out.
+func fetchJSON(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("erro ao criar requisição: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao executar requisição: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("resposta inesperada da API: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("erro ao decodificar resposta: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package pubsub implementa um hub simples de publicação/assinatura usado
+// para distribuir cotações recém-salvas aos consumidores de streaming (SSE,
+// WebSocket, etc).
+package pubsub
+
+import "sync"
+
+// Tick é o evento publicado a cada nova cotação salva.
+type Tick struct {
+	Bid string `json:"bid"`
+	Ts  int64  `json:"ts"`
+}
+
+// subscriberBuffer define a capacidade do canal de cada assinante. Um
+// consumidor lento que não drene o canal a tempo perde ticks em vez de
+// travar o publisher.
+const subscriberBuffer = 8
+
+// Hub distribui Ticks para múltiplos assinantes, um canal por assinante.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[chan Tick]struct{}
+}
+
+// NewHub cria um hub vazio, pronto para uso.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[chan Tick]struct{})}
+}
+
+// Subscribe registra um novo assinante e retorna o canal onde os ticks
+// chegarão, junto de uma função unsubscribe que deve ser chamada (via
+// defer) quando o consumidor encerrar.
+func (h *Hub) Subscribe() (ch <-chan Tick, unsubscribe func()) {
+	sub := make(chan Tick, subscriberBuffer)
+
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subs[sub]; ok {
+			delete(h.subs, sub)
+			close(sub)
+		}
+	}
+}
+
+// Publish envia o tick para todos os assinantes. O envio é não bloqueante:
+// se o canal de um assinante estiver cheio, o tick é descartado para aquele
+// assinante em vez de bloquear os demais.
+func (h *Hub) Publish(tick Tick) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		select {
+		case sub <- tick:
+		default:
+			// consumidor lento: descarta o tick para não travar o hub.
+		}
+	}
+}
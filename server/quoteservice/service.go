@@ -0,0 +1,133 @@
+// Package quoteservice contém a lógica central de cotações, compartilhada
+// pelos três transportes do servidor: REST, WebSocket e gRPC.
+package quoteservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/wellalencarweb/client-server-api/metrics"
+	"github.com/wellalencarweb/client-server-api/server/cache"
+	"github.com/wellalencarweb/client-server-api/server/provider"
+	"github.com/wellalencarweb/client-server-api/server/pubsub"
+	"github.com/wellalencarweb/client-server-api/server/store"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var tracer = otel.Tracer("github.com/wellalencarweb/client-server-api/server/quoteservice")
+
+// Quote representa o modelo da tabela de cotações, definido no pacote store
+// para que o backend de persistência continue sendo a fonte da verdade.
+type Quote = store.Quote
+
+const latestCacheKey = "latest"
+
+// MaxHistoryLimit é o teto aplicado a History independentemente do
+// transporte (REST, WebSocket ou gRPC), evitando que um chamador peça um
+// intervalo/limite irrestrito ao Store.
+const MaxHistoryLimit = 500
+
+// Service concentra a lógica de busca, persistência e distribuição de
+// cotações usada pelos handlers REST, WebSocket e gRPC.
+type Service struct {
+	store store.Store
+	chain *provider.Chain
+	hub   *pubsub.Hub
+	cache *cache.LRU
+}
+
+// New monta o Service a partir de suas dependências já inicializadas.
+func New(st store.Store, chain *provider.Chain, hub *pubsub.Hub, latestCache *cache.LRU) *Service {
+	return &Service{store: st, chain: chain, hub: hub, cache: latestCache}
+}
+
+// FetchAndSave busca uma nova cotação na cadeia de provedores, salva no
+// banco e publica o resultado para os assinantes (SSE, WebSocket), além de
+// atualizar o cache de última cotação. O tempo total da busca é governado
+// pelo timeout por tentativa de cada provedor na Chain, não por um deadline
+// agregado aqui — do contrário, o primário lento consumiria o orçamento
+// inteiro e o fallback nunca chegaria a tentar os demais provedores.
+func (s *Service) FetchAndSave(ctx context.Context, insertTimeout time.Duration) (Quote, error) {
+	fetched, err := s.chain.Fetch(ctx)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	// context.WithoutCancel mantém o trace/span da requisição original sem
+	// herdar seu cancelamento, para que o insert não seja abortado junto com
+	// a conexão do cliente mas ainda apareça como filho do trace de ponta a
+	// ponta.
+	insertCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), insertTimeout)
+	defer cancel()
+
+	saved, err := s.save(insertCtx, fetched.Bid)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	s.cache.Set(latestCacheKey, saved)
+	s.hub.Publish(pubsub.Tick{Bid: saved.Bid, Ts: saved.CreatedAt.Unix()})
+
+	return saved, nil
+}
+
+func (s *Service) save(ctx context.Context, bid string) (Quote, error) {
+	ctx, span := tracer.Start(ctx, "saveQuote")
+	defer span.End()
+
+	start := time.Now()
+	quote := Quote{Bid: bid, CreatedAt: time.Now()}
+	err := s.store.Save(ctx, quote)
+	metrics.ObserveDBInsert(time.Since(start))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return Quote{}, err
+	}
+	return quote, nil
+}
+
+// GetLatest retorna a cotação mais recente, preferencialmente a partir do
+// cache, caindo para o Store em caso de miss.
+func (s *Service) GetLatest(ctx context.Context) (Quote, error) {
+	if cached, ok := s.cache.Get(latestCacheKey); ok {
+		metrics.IncCacheHit()
+		return cached.(Quote), nil
+	}
+
+	quote, err := s.store.Latest(ctx)
+	if err != nil {
+		return Quote{}, err
+	}
+	s.cache.Set(latestCacheKey, quote)
+	return quote, nil
+}
+
+// History retorna as cotações salvas no intervalo [from, to], mais
+// recentes primeiro, respeitando o limit informado até o teto de
+// MaxHistoryLimit, aplicado aqui para valer para todos os transportes.
+func (s *Service) History(ctx context.Context, from, to time.Time, limit int) ([]Quote, error) {
+	if limit <= 0 || limit > MaxHistoryLimit {
+		limit = MaxHistoryLimit
+	}
+	return s.store.Range(ctx, from, to, limit)
+}
+
+// Subscribe registra um novo assinante de ticks de cotação (usado por SSE e
+// WebSocket). A função unsubscribe deve ser chamada quando o consumidor
+// encerrar.
+func (s *Service) Subscribe() (ticks <-chan pubsub.Tick, unsubscribe func()) {
+	return s.hub.Subscribe()
+}
+
+// ProvidersHealth retorna o estado do circuit breaker de cada provedor de
+// cotação configurado.
+func (s *Service) ProvidersHealth() []provider.Health {
+	return s.chain.Health()
+}
+
+// Close libera os recursos do Store subjacente, usado no desligamento gracioso do servidor.
+func (s *Service) Close() error {
+	return s.store.Close()
+}
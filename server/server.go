@@ -8,25 +8,43 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 	"time"
 
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/wellalencarweb/client-server-api/metrics"
+	"github.com/wellalencarweb/client-server-api/server/cache"
+	"github.com/wellalencarweb/client-server-api/server/grpcserver"
+	"github.com/wellalencarweb/client-server-api/server/provider"
+	"github.com/wellalencarweb/client-server-api/server/pubsub"
+	"github.com/wellalencarweb/client-server-api/server/quoteservice"
+	"github.com/wellalencarweb/client-server-api/server/store"
+	"github.com/wellalencarweb/client-server-api/telemetry"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+// latestCacheSize define a capacidade do cache LRU usado por /cotacao/latest.
+const latestCacheSize = 16
+
+// shutdownTimeout é o prazo máximo para o desligamento gracioso do servidor.
+const shutdownTimeout = 5 * time.Second
+
 // Config armazena configurações do servidor
 type Config struct {
 	ServerAddress string
+	GRPCAddress   string
 	QuotesAPIURL  string
 	FetchTimeout  time.Duration
 	InsertTimeout time.Duration
-}
 
-// Quote representa o modelo da tabela de cotações
-type Quote struct {
-	ID        uint      `gorm:"primaryKey"`
-	Bid       string    `gorm:"not null"`
-	CreatedAt time.Time `gorm:"autoCreateTime;not null"`
+	ExchangeHostURL string
+	OfflineStubBid  string
+
+	StorageDriver         string
+	StorageDSN            string
+	StorageMemoryCapacity int
 }
 
 const (
@@ -35,28 +53,87 @@ const (
 	ErrDatabaseInsert   = "Erro ao inserir no banco de dados"
 )
 
-// main inicializa o servidor, carregando as configurações e configurando o banco de dados.
-// Ele define o endpoint HTTP para tratar requisições de cotação e inicia o servidor.
+// main inicializa o servidor, carregando as configurações e abrindo o Store.
+// Ele expõe o serviço de cotações via REST, WebSocket e gRPC, compartilhando
+// o mesmo QuoteService, e encerra graciosamente ao receber SIGINT/SIGTERM.
 func main() {
 	log.Println("Iniciando servidor...")
 
+	ctx := context.Background()
+	shutdownTracing, err := telemetry.Init(ctx, telemetry.LoadConfig("client-server-api-server"))
+	if err != nil {
+		log.Fatalf("Erro ao configurar tracing: %v", err)
+		return
+	}
+	defer shutdownTracing(ctx)
+
 	config, err := loadConfig()
 	if err != nil {
 		log.Fatalf("Erro ao carregar configurações: %v", err)
 		return
 	}
 
-	db, err := setupDatabase()
+	st, err := store.Open(store.Config{
+		Driver:         config.StorageDriver,
+		DSN:            config.StorageDSN,
+		MemoryCapacity: config.StorageMemoryCapacity,
+	})
 	if err != nil {
 		log.Fatalf("Erro ao configurar banco de dados: %v", err)
 		return
 	}
 
-	http.HandleFunc("/cotacao", handleQuote(config, db))
-	log.Printf("Servidor ouvindo em %s", config.ServerAddress)
+	chain := buildProviderChain(config)
+	chain.Observer = func(providerName string, duration time.Duration, err error) {
+		metrics.ObserveFetch(providerName, duration)
+		if err != nil {
+			metrics.IncUpstreamError(providerName)
+		}
+	}
+	hub := pubsub.NewHub()
+	latestCache := cache.New(latestCacheSize)
+	svc := quoteservice.New(st, chain, hub, latestCache)
+
+	grpcServer := grpcserver.New(svc)
+	go func() {
+		log.Printf("Servidor gRPC ouvindo em %s", config.GRPCAddress)
+		if err := grpcserver.Serve(grpcServer, config.GRPCAddress); err != nil {
+			log.Fatalf("Erro ao iniciar servidor gRPC: %v", err)
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle("/cotacao", otelhttp.NewHandler(handleQuote(config, svc), "cotacao"))
+	mux.Handle("/health/providers", otelhttp.NewHandler(handleProvidersHealth(svc), "health-providers"))
+	mux.Handle("/cotacao/history", otelhttp.NewHandler(handleHistory(svc), "cotacao-history"))
+	mux.Handle("/cotacao/latest", otelhttp.NewHandler(handleLatest(svc), "cotacao-latest"))
+	mux.Handle("/cotacao/stream", otelhttp.NewHandler(handleStream(svc), "cotacao-stream"))
+	mux.HandleFunc("/ws", handleWebSocket(svc))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	httpServer := &http.Server{Addr: config.ServerAddress, Handler: mux}
+
+	go func() {
+		log.Printf("Servidor REST+WS ouvindo em %s", config.ServerAddress)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Erro ao iniciar servidor: %v", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	log.Println("Encerrando servidor...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
 
-	if err := http.ListenAndServe(config.ServerAddress, nil); err != nil {
-		log.Fatalf("Erro ao iniciar servidor: %v", err)
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Erro ao encerrar servidor REST+WS: %v", err)
+	}
+	grpcServer.GracefulStop()
+	if err := svc.Close(); err != nil {
+		log.Printf("Erro ao encerrar banco de dados: %v", err)
 	}
 }
 
@@ -74,14 +151,56 @@ func loadConfig() (*Config, error) {
 		return nil, fmt.Errorf("erro ao parsear INSERT_TIMEOUT (%s): %w", insertTimeoutStr, err)
 	}
 
+	memoryCapacityStr := getEnv("STORAGE_MEMORY_CAPACITY", "1000")
+	memoryCapacity, err := strconv.Atoi(memoryCapacityStr)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao parsear STORAGE_MEMORY_CAPACITY (%s): %w", memoryCapacityStr, err)
+	}
+
 	return &Config{
-		ServerAddress: getEnv("SERVER_ADDRESS", ":8080"),
-		QuotesAPIURL:  getEnv("QUOTES_API_URL", "https://economia.awesomeapi.com.br/json/last/USD-BRL"),
-		FetchTimeout:  fetchTimeout,
-		InsertTimeout: insertTimeout,
+		ServerAddress:   getEnv("SERVER_ADDRESS", ":8080"),
+		GRPCAddress:     getEnv("GRPC_ADDRESS", ":9090"),
+		QuotesAPIURL:    getEnv("QUOTES_API_URL", "https://economia.awesomeapi.com.br/json/last/USD-BRL"),
+		FetchTimeout:    fetchTimeout,
+		InsertTimeout:   insertTimeout,
+		ExchangeHostURL: getEnv("EXCHANGEHOST_API_URL", "https://api.exchangerate.host/latest?base=USD"),
+		OfflineStubBid:  getEnv("OFFLINE_STUB_BID", "5.00"),
+
+		StorageDriver:         getEnv("STORAGE_DRIVER", "sqlite"),
+		StorageDSN:            getEnv("STORAGE_DSN", ""),
+		StorageMemoryCapacity: memoryCapacity,
 	}, nil
 }
 
+// buildProviderChain monta a cadeia de provedores de cotação na ordem em que
+// devem ser tentados: AwesomeAPI (primário), exchangerate.host (fallback) e,
+// por fim, um stub offline para não deixar o serviço completamente fora do ar.
+func buildProviderChain(config *Config) *provider.Chain {
+	return provider.NewChain(provider.DefaultRetryPolicy,
+		provider.ChainOption{
+			Provider:       provider.NewAwesomeAPIProvider(config.QuotesAPIURL, nil),
+			Timeout:        config.FetchTimeout,
+			ErrorThreshold: 0.5,
+			MinSamples:     5,
+			ResetInterval:  30 * time.Second,
+		},
+		provider.ChainOption{
+			Provider:       provider.NewExchangeHostProvider(config.ExchangeHostURL, nil),
+			Timeout:        config.FetchTimeout,
+			ErrorThreshold: 0.5,
+			MinSamples:     5,
+			ResetInterval:  30 * time.Second,
+		},
+		provider.ChainOption{
+			Provider:       provider.NewOfflineStubProvider(config.OfflineStubBid),
+			Timeout:        config.FetchTimeout,
+			ErrorThreshold: 1,
+			MinSamples:     1,
+			ResetInterval:  30 * time.Second,
+		},
+	)
+}
+
 // getEnv retorna o valor de uma variável de ambiente ou um fallback
 func getEnv(key, fallback string) string {
 	value, exists := os.LookupEnv(key)
@@ -91,108 +210,44 @@ func getEnv(key, fallback string) string {
 	return value
 }
 
-// setupDatabase configura o banco de dados e aplica as migrações
-func setupDatabase() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open("quotes.db"), &gorm.Config{})
-	if err != nil {
-		return nil, fmt.Errorf("erro ao conectar ao banco de dados: %w", err)
-	}
-
-	if db == nil {
-		return nil, errors.New("banco de dados não inicializado corretamente")
-	}
-
-	if err := db.AutoMigrate(&Quote{}); err != nil {
-		return nil, fmt.Errorf("erro ao realizar migração no banco de dados: %w", err)
-	}
-
-	return db, nil
-}
-
 // handleQuote processa requisições para o endpoint /cotacao
-func handleQuote(config *Config, db *gorm.DB) http.HandlerFunc {
+func handleQuote(config *Config, svc *quoteservice.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Println("Recebendo requisição para /cotacao")
 
-		if config == nil {
-			http.Error(w, "Configuração do servidor ausente", http.StatusInternalServerError)
-			return
-		}
-		if db == nil {
-			http.Error(w, "Banco de dados não inicializado", http.StatusInternalServerError)
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(r.Context(), config.FetchTimeout)
-		defer cancel()
-
-		bid, err := fetchQuote(ctx, config.QuotesAPIURL)
+		quote, err := svc.FetchAndSave(r.Context(), config.InsertTimeout)
 		if err != nil {
+			if errors.Is(err, provider.ErrAllProvidersFailed) {
+				log.Printf("%s: %v", ErrFetchingQuote, err)
+				http.Error(w, "Erro ao buscar cotação", http.StatusInternalServerError)
+				return
+			}
 			if errors.Is(err, context.DeadlineExceeded) {
 				log.Printf("%s: %v", ErrFetchingQuote, err)
 				http.Error(w, "Timeout na requisição à API", http.StatusGatewayTimeout)
 				return
 			}
-			log.Printf("%s: %v", ErrFetchingQuote, err)
-			http.Error(w, "Erro ao buscar cotação", http.StatusInternalServerError)
-			return
-		}
-
-		ctx, cancel = context.WithTimeout(context.Background(), config.InsertTimeout)
-		defer cancel()
-
-		if err := saveQuote(ctx, db, bid); err != nil {
 			log.Printf("%s: %v", ErrDatabaseInsert, err)
 			http.Error(w, "Erro ao salvar cotação no banco", http.StatusInternalServerError)
 			return
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(map[string]string{"bid": bid}); err != nil {
+		if err := json.NewEncoder(w).Encode(map[string]string{"bid": quote.Bid}); err != nil {
 			log.Printf("Erro ao codificar resposta JSON: %v", err)
 			http.Error(w, "Erro interno do servidor", http.StatusInternalServerError)
 		}
 	}
 }
 
-// fetchQuote busca a cotação na API externa
-func fetchQuote(ctx context.Context, url string) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return "", fmt.Errorf("erro ao criar requisição: %w", err)
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("erro ao executar requisição: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("resposta inesperada da API: %d", resp.StatusCode)
-	}
-
-	var data map[string]map[string]string
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return "", fmt.Errorf("%s: %w", ErrDecodingResponse, err)
-	}
-
-	bid, ok := data["USDBRL"]["bid"]
-	if !ok || bid == "" {
-		return "", errors.New("campo 'bid' ausente ou inválido na resposta")
-	}
-	return bid, nil
-}
-
-// saveQuote insere a cotação no banco de dados
-func saveQuote(ctx context.Context, db *gorm.DB, bid string) error {
-	if db == nil {
-		return errors.New("banco de dados nulo")
-	}
-
-	quote := Quote{Bid: bid}
-	if err := db.WithContext(ctx).Create(&quote).Error; err != nil {
-		return err
+// handleProvidersHealth expõe o estado do circuit breaker de cada provedor
+// de cotação configurado na cadeia.
+func handleProvidersHealth(svc *quoteservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(svc.ProvidersHealth()); err != nil {
+			log.Printf("Erro ao codificar resposta JSON: %v", err)
+			http.Error(w, "Erro interno do servidor", http.StatusInternalServerError)
+		}
 	}
-	return nil
 }
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// gormStore implementa Store sobre um *gorm.DB, usado pelos drivers
+// "sqlite", "postgres" e "mysql".
+type gormStore struct {
+	db *gorm.DB
+}
+
+// newGormStore aplica as migrações do modelo Quote e retorna o Store pronto para uso.
+func newGormStore(db *gorm.DB) (*gormStore, error) {
+	if err := db.AutoMigrate(&Quote{}); err != nil {
+		return nil, fmt.Errorf("store: erro ao realizar migração no banco de dados: %w", err)
+	}
+	return &gormStore{db: db}, nil
+}
+
+func (s *gormStore) Save(ctx context.Context, q Quote) error {
+	return s.db.WithContext(ctx).Create(&q).Error
+}
+
+func (s *gormStore) Latest(ctx context.Context) (Quote, error) {
+	var quote Quote
+	err := s.db.WithContext(ctx).Order("created_at DESC").First(&quote).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return Quote{}, ErrNotFound
+		}
+		return Quote{}, err
+	}
+	return quote, nil
+}
+
+func (s *gormStore) Range(ctx context.Context, from, to time.Time, limit int) ([]Quote, error) {
+	var quotes []Quote
+	err := s.db.WithContext(ctx).
+		Where("created_at BETWEEN ? AND ?", from, to).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&quotes).Error
+	return quotes, err
+}
+
+func (s *gormStore) Ping(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (s *gormStore) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
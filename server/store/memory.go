@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ringStore é um Store em memória para testes e desenvolvimento, guardando no
+// máximo capacity cotações. Leituras são lock-free: cada escrita publica uma
+// nova snapshot imutável via atomic.Value; um sync.Mutex apenas serializa
+// escritores entre si.
+type ringStore struct {
+	capacity int
+	writeMu  sync.Mutex
+	nextID   uint32
+	quotes   atomic.Value // []Quote, mais antigas primeiro
+}
+
+func newMemoryStore(capacity int) *ringStore {
+	s := &ringStore{capacity: capacity}
+	s.quotes.Store([]Quote{})
+	return s
+}
+
+func (s *ringStore) Save(_ context.Context, q Quote) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.nextID++
+	q.ID = uint(s.nextID)
+	if q.CreatedAt.IsZero() {
+		q.CreatedAt = time.Now()
+	}
+
+	current := s.quotes.Load().([]Quote)
+	updated := make([]Quote, 0, len(current)+1)
+	updated = append(updated, current...)
+	updated = append(updated, q)
+	if len(updated) > s.capacity {
+		updated = updated[len(updated)-s.capacity:]
+	}
+	s.quotes.Store(updated)
+	return nil
+}
+
+func (s *ringStore) Latest(_ context.Context) (Quote, error) {
+	quotes := s.quotes.Load().([]Quote)
+	if len(quotes) == 0 {
+		return Quote{}, ErrNotFound
+	}
+	return quotes[len(quotes)-1], nil
+}
+
+func (s *ringStore) Range(_ context.Context, from, to time.Time, limit int) ([]Quote, error) {
+	quotes := s.quotes.Load().([]Quote)
+	matched := make([]Quote, 0, len(quotes))
+	for _, q := range quotes {
+		if !q.CreatedAt.Before(from) && !q.CreatedAt.After(to) {
+			matched = append(matched, q)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].CreatedAt.After(matched[j].CreatedAt) })
+	if limit > 0 && len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+func (s *ringStore) Ping(_ context.Context) error {
+	return nil
+}
+
+func (s *ringStore) Close() error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRingStore_SaveAssignsSequentialIDs(t *testing.T) {
+	s := newMemoryStore(10)
+	ctx := context.Background()
+
+	if err := s.Save(ctx, Quote{Bid: "5.00"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := s.Save(ctx, Quote{Bid: "5.25"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	latest, err := s.Latest(ctx)
+	if err != nil {
+		t.Fatalf("Latest: %v", err)
+	}
+	if latest.Bid != "5.25" || latest.ID != 2 {
+		t.Fatalf("latest = %+v, esperado Bid=5.25 ID=2", latest)
+	}
+}
+
+func TestRingStore_LatestWithoutQuotesReturnsErrNotFound(t *testing.T) {
+	s := newMemoryStore(10)
+
+	if _, err := s.Latest(context.Background()); err != ErrNotFound {
+		t.Fatalf("Latest() err = %v, esperado ErrNotFound", err)
+	}
+}
+
+func TestRingStore_SaveEvictsOldestBeyondCapacity(t *testing.T) {
+	s := newMemoryStore(2)
+	ctx := context.Background()
+
+	for _, bid := range []string{"5.00", "5.25", "5.50"} {
+		if err := s.Save(ctx, Quote{Bid: bid}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	quotes, err := s.Range(ctx, time.Unix(0, 0), time.Now().Add(time.Hour), 0)
+	if err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+	if len(quotes) != 2 {
+		t.Fatalf("len(quotes) = %d, esperado 2 (capacidade do ring)", len(quotes))
+	}
+	if quotes[0].Bid != "5.50" || quotes[1].Bid != "5.25" {
+		t.Fatalf("quotes = %+v, esperado [5.50, 5.25] (mais recentes primeiro)", quotes)
+	}
+}
+
+func TestRingStore_RangeFiltersByIntervalAndLimit(t *testing.T) {
+	s := newMemoryStore(10)
+	ctx := context.Background()
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	quotesIn := []Quote{
+		{Bid: "5.00", CreatedAt: base},
+		{Bid: "5.25", CreatedAt: base.Add(time.Hour)},
+		{Bid: "5.50", CreatedAt: base.Add(2 * time.Hour)},
+	}
+	for _, q := range quotesIn {
+		if err := s.Save(ctx, q); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	quotes, err := s.Range(ctx, base.Add(time.Hour), base.Add(2*time.Hour), 0)
+	if err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+	if len(quotes) != 2 {
+		t.Fatalf("len(quotes) = %d, esperado 2 dentro do intervalo", len(quotes))
+	}
+
+	limited, err := s.Range(ctx, base, base.Add(2*time.Hour), 1)
+	if err != nil {
+		t.Fatalf("Range: %v", err)
+	}
+	if len(limited) != 1 || limited[0].Bid != "5.50" {
+		t.Fatalf("limited = %+v, esperado apenas a mais recente (5.50)", limited)
+	}
+}
@@ -0,0 +1,19 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func openMySQL(dsn string) (Store, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("store: STORAGE_DSN é obrigatório para o driver mysql")
+	}
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("store: erro ao conectar ao banco de dados mysql: %w", err)
+	}
+	return newGormStore(db)
+}
@@ -0,0 +1,19 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func openPostgres(dsn string) (Store, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("store: STORAGE_DSN é obrigatório para o driver postgres")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("store: erro ao conectar ao banco de dados postgres: %w", err)
+	}
+	return newGormStore(db)
+}
@@ -0,0 +1,21 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const defaultSQLiteDSN = "quotes.db"
+
+func openSQLite(dsn string) (Store, error) {
+	if dsn == "" {
+		dsn = defaultSQLiteDSN
+	}
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("store: erro ao conectar ao banco de dados sqlite: %w", err)
+	}
+	return newGormStore(db)
+}
@@ -0,0 +1,70 @@
+// Package store abstrai o backend de persistência de cotações atrás da
+// interface Store, com implementações para SQLite, Postgres, MySQL (via
+// GORM) e um backend em memória para testes e desenvolvimento.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Quote é o modelo de persistência de uma cotação.
+type Quote struct {
+	ID        uint      `gorm:"primaryKey"`
+	Bid       string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// ErrNotFound é retornado por Latest quando não há nenhuma cotação salva.
+var ErrNotFound = errors.New("store: nenhuma cotação encontrada")
+
+// Store abstrai o backend de persistência de cotações usado pelo QuoteService.
+type Store interface {
+	// Save insere uma nova cotação.
+	Save(ctx context.Context, q Quote) error
+	// Latest retorna a cotação mais recente, ou ErrNotFound se não houver nenhuma.
+	Latest(ctx context.Context) (Quote, error)
+	// Range retorna as cotações salvas entre from e to, mais recentes
+	// primeiro, respeitando limit.
+	Range(ctx context.Context, from, to time.Time, limit int) ([]Quote, error)
+	// Ping verifica a conectividade com o backend de armazenamento.
+	Ping(ctx context.Context) error
+	// Close libera os recursos do backend de armazenamento.
+	Close() error
+}
+
+// Config seleciona e configura o backend de armazenamento, tipicamente lido
+// das variáveis de ambiente STORAGE_DRIVER e STORAGE_DSN.
+type Config struct {
+	// Driver é "sqlite" (default), "postgres", "mysql" ou "memory".
+	Driver string
+	// DSN é a string de conexão, no formato esperado pelo driver GORM
+	// correspondente. Ignorado pelo driver "memory".
+	DSN string
+	// MemoryCapacity é o número máximo de cotações retidas pelo driver
+	// "memory". Ignorado pelos demais drivers.
+	MemoryCapacity int
+}
+
+// Open abre o Store configurado por cfg.Driver, aplicando as migrações
+// necessárias antes de retornar.
+func Open(cfg Config) (Store, error) {
+	switch cfg.Driver {
+	case "", "sqlite":
+		return openSQLite(cfg.DSN)
+	case "postgres":
+		return openPostgres(cfg.DSN)
+	case "mysql":
+		return openMySQL(cfg.DSN)
+	case "memory":
+		capacity := cfg.MemoryCapacity
+		if capacity <= 0 {
+			capacity = 1000
+		}
+		return newMemoryStore(capacity), nil
+	default:
+		return nil, fmt.Errorf("store: driver de armazenamento desconhecido: %q", cfg.Driver)
+	}
+}
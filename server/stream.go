@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/wellalencarweb/client-server-api/server/quoteservice"
+)
+
+// handleStream processa GET /cotacao/stream, implementando Server-Sent
+// Events: a cada cotação nova salva pelo servidor, um evento
+// `{"bid":...,"ts":...}` é enviado ao cliente conectado.
+func handleStream(svc *quoteservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming não suportado", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticks, unsubscribe := svc.Subscribe()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case tick, ok := <-ticks:
+				if !ok {
+					return
+				}
+				payload, err := json.Marshal(tick)
+				if err != nil {
+					log.Printf("Erro ao codificar tick SSE: %v", err)
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "data: %s\n\n", payload); err != nil {
+					log.Printf("Erro ao escrever evento SSE: %v", err)
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/wellalencarweb/client-server-api/server/pubsub"
+	"github.com/wellalencarweb/client-server-api/server/quoteservice"
+)
+
+// defaultPollInterval é usado quando o cliente não assina um intervalo
+// explícito antes do defaultSubscribeWait expirar.
+const (
+	defaultPollInterval  = 2 * time.Second
+	minPollInterval      = 250 * time.Millisecond
+	defaultSubscribeWait = 2 * time.Second
+)
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// O protocolo é consumido por qualquer origem, assim como o REST.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// subscribeMessage é a mensagem que o cliente envia para definir o intervalo
+// de entrega da cotação. Só a primeira mensagem da conexão é lida; mensagens
+// posteriores não alteram o intervalo.
+type subscribeMessage struct {
+	IntervalMs int64 `json:"interval_ms"`
+}
+
+// handleWebSocket processa GET /ws, fazendo upgrade para WebSocket e
+// assinando o mesmo hub de pub/sub usado por server/stream.go (SSE) e
+// grpcserver.Stream. A cotação mais recente recebida do hub é entregue ao
+// ritmo pedido pelo cliente através de uma subscribeMessage (em vez de a
+// cada publicação), para não saturar clientes que pediram um intervalo
+// maior; ticks publicados entre duas entregas são substituídos pelo mais
+// recente e não chegam ao cliente.
+func handleWebSocket(svc *quoteservice.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := wsUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Printf("Erro ao fazer upgrade para WebSocket: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		interval := readSubscribeInterval(conn)
+
+		ticks, unsubscribe := svc.Subscribe()
+		defer unsubscribe()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var latest pubsub.Tick
+		var hasLatest bool
+
+		ctx := r.Context()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tick, ok := <-ticks:
+				if !ok {
+					return
+				}
+				latest = tick
+				hasLatest = true
+			case <-ticker.C:
+				if !hasLatest {
+					continue
+				}
+				if err := conn.WriteJSON(latest); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// readSubscribeInterval aguarda a subscribeMessage inicial do cliente, com
+// um timeout curto, caindo para defaultPollInterval se nada chegar ou a
+// mensagem for inválida.
+func readSubscribeInterval(conn *websocket.Conn) time.Duration {
+	conn.SetReadDeadline(time.Now().Add(defaultSubscribeWait))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var msg subscribeMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		return defaultPollInterval
+	}
+
+	interval := time.Duration(msg.IntervalMs) * time.Millisecond
+	if interval < minPollInterval {
+		return defaultPollInterval
+	}
+	return interval
+}
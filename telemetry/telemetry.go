@@ -0,0 +1,80 @@
+// Package telemetry configura o tracing distribuído (OpenTelemetry) usado
+// pelo cliente e pelo servidor, propagado via cabeçalhos W3C traceparent.
+package telemetry
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Config controla a exportação OTLP do tracing. Um OTLPEndpoint vazio
+// desativa a exportação, mantendo apenas um TracerProvider no-op.
+type Config struct {
+	ServiceName  string
+	OTLPEndpoint string
+	SampleRatio  float64
+}
+
+// LoadConfig lê a configuração de tracing de variáveis de ambiente:
+// OTEL_SERVICE_NAME (default: serviceName), OTEL_EXPORTER_OTLP_ENDPOINT
+// (default: vazio, sem exportação) e OTEL_TRACES_SAMPLER_ARG (default: 1.0).
+func LoadConfig(serviceName string) Config {
+	name := os.Getenv("OTEL_SERVICE_NAME")
+	if name == "" {
+		name = serviceName
+	}
+
+	ratio := 1.0
+	if v := os.Getenv("OTEL_TRACES_SAMPLER_ARG"); v != "" {
+		if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+			ratio = parsed
+		}
+	}
+
+	return Config{
+		ServiceName:  name,
+		OTLPEndpoint: os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		SampleRatio:  ratio,
+	}
+}
+
+// Init configura o TracerProvider e o propagador globais e retorna uma
+// função de shutdown a ser chamada (via defer) antes do processo encerrar.
+// Sem OTLPEndpoint configurado, usa um TracerProvider sem exportador: spans
+// são criados normalmente mas descartados (no-op).
+func Init(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	propagator := propagation.TraceContext{}
+	otel.SetTextMapPropagator(propagator)
+
+	if cfg.OTLPEndpoint == "" {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpoint(cfg.OTLPEndpoint), otlptracehttp.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceNameKey.String(cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}